@@ -0,0 +1,163 @@
+// Command uncovered reports uncovered lines and functions from a Go
+// coverage profile produced by `go test -coverprofile`.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hanpama/uncovered/coverage"
+	"github.com/hanpama/uncovered/export"
+	"github.com/hanpama/uncovered/htmlrenderer"
+	"github.com/hanpama/uncovered/renderer"
+)
+
+// options holds the parsed command-line flags.
+type options struct {
+	funcMode  bool
+	threshold float64
+	diff      bool
+	review    bool
+	html      string
+	merge     string
+	lcov      string
+	cobertura string
+}
+
+func main() {
+	var opts options
+	flag.BoolVar(&opts.funcMode, "func", false, "report coverage per function instead of per line, like `go tool cover -func`")
+	flag.Float64Var(&opts.threshold, "threshold", 0, "with -func, only print functions whose coverage is below this percentage")
+	flag.BoolVar(&opts.diff, "diff", false, "report uncovered lines as a unified diff instead of the default annotated listing")
+	flag.BoolVar(&opts.review, "review", false, "report uncovered lines as a JSON array of pull-request review comments")
+	flag.StringVar(&opts.html, "html", "", "write an HTML coverage report with a per-line heatmap to this path")
+	flag.StringVar(&opts.merge, "merge", "", "comma-separated list of additional coverage profiles to merge with the input profile")
+	flag.StringVar(&opts.lcov, "lcov", "", "write an LCOV tracefile to this path")
+	flag.StringVar(&opts.cobertura, "cobertura", "", "write a Cobertura XML report to this path")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: uncovered [flags] <coverage-profile>")
+		os.Exit(2)
+	}
+
+	if err := run(flag.Arg(0), opts); err != nil {
+		fmt.Fprintln(os.Stderr, "uncovered:", err)
+		os.Exit(1)
+	}
+}
+
+func run(profilePath string, opts options) error {
+	profile, err := coverage.ParseProfileFile(profilePath)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", profilePath, err)
+	}
+
+	if opts.merge != "" {
+		profile, err = mergeProfiles(profile, strings.Split(opts.merge, ","))
+		if err != nil {
+			return err
+		}
+	}
+
+	exported := false
+
+	if opts.html != "" {
+		if err := writeHTMLReport(opts.html, profile); err != nil {
+			return err
+		}
+		exported = true
+	}
+
+	if opts.lcov != "" {
+		if err := writeExport(opts.lcov, profile, export.LCOV); err != nil {
+			return err
+		}
+		exported = true
+	}
+
+	if opts.cobertura != "" {
+		if err := writeExport(opts.cobertura, profile, export.Cobertura); err != nil {
+			return err
+		}
+		exported = true
+	}
+
+	if opts.funcMode {
+		functions, err := coverage.GetUncoveredFunctions(profile, profileFileNames(profile)...)
+		if err != nil {
+			return err
+		}
+		fr := renderer.NewFunc()
+		fr.Threshold = opts.threshold
+		return fr.Render(os.Stdout, functions)
+	}
+
+	uncovered := coverage.GetUncoveredLines(profile)
+
+	switch {
+	case opts.diff:
+		return renderer.NewPatch().Render(os.Stdout, uncovered)
+	case opts.review:
+		return renderer.NewReview().Render(os.Stdout, uncovered)
+	case exported:
+		return nil
+	default:
+		return renderer.New().Render(os.Stdout, uncovered)
+	}
+}
+
+// mergeProfiles parses each path in extraPaths and merges it with profile.
+func mergeProfiles(profile *coverage.Profile, extraPaths []string) (*coverage.Profile, error) {
+	profiles := []*coverage.Profile{profile}
+	for _, path := range extraPaths {
+		extra, err := coverage.ParseProfileFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		profiles = append(profiles, extra)
+	}
+	return coverage.Merge(profiles...)
+}
+
+// writeHTMLReport renders profile's coverage heatmap to path.
+func writeHTMLReport(path string, profile *coverage.Profile) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return htmlrenderer.Render(file, coverage.GetUncoveredBlocks(profile), coverage.GetCoveredBlocks(profile))
+}
+
+// writeExport runs render over profile and writes the result to path.
+func writeExport(path string, profile *coverage.Profile, render func(w io.Writer, profile *coverage.Profile) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return render(file, profile)
+}
+
+// profileFileNames returns the sorted, deduplicated set of file names
+// referenced by profile's blocks.
+func profileFileNames(profile *coverage.Profile) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, block := range profile.Blocks {
+		if seen[block.FileName] {
+			continue
+		}
+		seen[block.FileName] = true
+		names = append(names, block.FileName)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestRunAgainstRealProfile runs `go test -coverprofile` against the example
+// package and feeds the resulting profile through run, end to end. Real
+// profiles record file names as import paths rather than disk paths, which
+// previously made every mode fail with "no such file or directory"; this
+// guards against that regressing.
+func TestRunAgainstRealProfile(t *testing.T) {
+	moduleRoot, err := filepath.Abs("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profilePath := filepath.Join(t.TempDir(), "cov.out")
+	cmd := exec.Command("go", "test", "-coverprofile="+profilePath, "./example/...")
+	cmd.Dir = moduleRoot
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("go test -coverprofile: %v\n%s", err, stderr.String())
+	}
+
+	out := captureStdout(t, func() {
+		if err := run(profilePath, options{funcMode: true}); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+	})
+	if !strings.Contains(out, "(*Calculator).Divide") {
+		t.Errorf("func-mode output missing expected function name:\n%s", out)
+	}
+
+	out = captureStdout(t, func() {
+		if err := run(profilePath, options{}); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+	})
+	if !strings.Contains(out, "func (*Calculator) Divide") {
+		t.Errorf("default report missing function name in group header, meaning boundary snapping didn't fire:\n%s", out)
+	}
+
+	out = captureStdout(t, func() {
+		if err := run(profilePath, options{diff: true}); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+	})
+	if !strings.Contains(out, "--- a/") {
+		t.Errorf("diff output missing a diff header:\n%s", out)
+	}
+
+	lcovPath := filepath.Join(t.TempDir(), "out.info")
+	out = captureStdout(t, func() {
+		if err := run(profilePath, options{lcov: lcovPath}); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+	})
+	if out != "" {
+		t.Errorf("requesting -lcov alone should not also print the default report, got:\n%s", out)
+	}
+	if _, err := os.Stat(lcovPath); err != nil {
+		t.Errorf("lcov file was not written: %v", err)
+	}
+}
+
+// captureStdout redirects os.Stdout for the duration of fn and returns
+// everything written to it.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orig := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = orig }()
+
+	fn()
+
+	w.Close()
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	return buf.String()
+}
@@ -0,0 +1,59 @@
+package coverage
+
+import (
+	"sort"
+)
+
+// CoverageBlock represents one contiguous line range annotated with
+// whether it was exercised during the test run.
+type CoverageBlock struct {
+	StartLine int
+	EndLine   int
+	Covered   bool
+}
+
+// FileBlocks groups the coverage blocks for a single source file.
+type FileBlocks struct {
+	FileName string
+	Blocks   []CoverageBlock
+}
+
+// GetUncoveredBlocks returns, for each file in profile, the blocks that
+// were never executed. Unlike GetUncoveredLines, blocks are reported as
+// line ranges rather than expanded into individual lines.
+func GetUncoveredBlocks(profile *Profile) []*FileBlocks {
+	return getBlocksByState(profile, false)
+}
+
+// GetCoveredBlocks returns, for each file in profile, the blocks that were
+// executed at least once. Used alongside GetUncoveredBlocks to distinguish
+// covered, uncovered, and non-executable lines.
+func GetCoveredBlocks(profile *Profile) []*FileBlocks {
+	return getBlocksByState(profile, true)
+}
+
+func getBlocksByState(profile *Profile, covered bool) []*FileBlocks {
+	fileMap := make(map[string][]CoverageBlock)
+	var order []string
+
+	for _, block := range profile.Blocks {
+		if block.IsCovered() != covered {
+			continue
+		}
+		if _, exists := fileMap[block.FileName]; !exists {
+			order = append(order, block.FileName)
+		}
+		fileMap[block.FileName] = append(fileMap[block.FileName], CoverageBlock{
+			StartLine: block.StartLine,
+			EndLine:   block.EndLine,
+			Covered:   covered,
+		})
+	}
+
+	sort.Strings(order)
+	result := make([]*FileBlocks, 0, len(order))
+	for _, fileName := range order {
+		result = append(result, &FileBlocks{FileName: fileName, Blocks: fileMap[fileName]})
+	}
+	return result
+}
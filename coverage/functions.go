@@ -0,0 +1,130 @@
+package coverage
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+)
+
+// FunctionCoverage represents coverage statistics for a single function or
+// method declaration.
+type FunctionCoverage struct {
+	FileName   string // Relative path to the source file
+	FuncName   string // Function name, including receiver for methods (e.g. "(*Calculator).Divide")
+	StartLine  int    // Line number where the declaration begins
+	EndLine    int    // Line number where the declaration ends
+	Statements int    // Total number of statements covered by profile blocks within the function
+	Covered    int    // Number of those statements with a non-zero execution count
+}
+
+// Percent returns the percentage of statements covered. A function with no
+// statements is reported as fully covered.
+func (f *FunctionCoverage) Percent() float64 {
+	if f.Statements == 0 {
+		return 100
+	}
+	return 100 * float64(f.Covered) / float64(f.Statements)
+}
+
+// GetUncoveredFunctions reports per-function coverage statistics for every
+// function and method declared in fileNames.
+//
+// For each declaration it parses the file with go/parser, determines the
+// line range spanned by the declaration, and cross-references the blocks
+// in profile that fall within that range to compute the number of covered
+// and total statements.
+//
+// The returned slice is sorted by file name and then by the line on which
+// each function is declared.
+func GetUncoveredFunctions(profile *Profile, fileNames ...string) ([]*FunctionCoverage, error) {
+	var result []*FunctionCoverage
+
+	for _, fileName := range fileNames {
+		sourcePath, err := ResolveSourceFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		fset := token.NewFileSet()
+		astFile, err := parser.ParseFile(fset, sourcePath, nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", sourcePath, err)
+		}
+
+		var blocks []Block
+		for _, block := range profile.Blocks {
+			if block.FileName == fileName {
+				blocks = append(blocks, block)
+			}
+		}
+
+		ast.Inspect(astFile, func(n ast.Node) bool {
+			decl, ok := n.(*ast.FuncDecl)
+			if !ok {
+				return true
+			}
+
+			fc := &FunctionCoverage{
+				FileName:  fileName,
+				FuncName:  funcName(decl),
+				StartLine: fset.Position(decl.Pos()).Line,
+				EndLine:   fset.Position(decl.End()).Line,
+			}
+
+			for _, block := range blocks {
+				if block.StartLine < fc.StartLine || block.EndLine > fc.EndLine {
+					continue
+				}
+				fc.Statements += block.NumStmt
+				if block.IsCovered() {
+					fc.Covered += block.NumStmt
+				}
+			}
+
+			result = append(result, fc)
+			return true
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].FileName != result[j].FileName {
+			return result[i].FileName < result[j].FileName
+		}
+		return result[i].StartLine < result[j].StartLine
+	})
+
+	return result, nil
+}
+
+// funcName returns a display name for decl, including the receiver type for
+// methods (e.g. "(*Calculator).Divide").
+func funcName(decl *ast.FuncDecl) string {
+	recv := ReceiverName(decl)
+	if recv == "" {
+		return decl.Name.Name
+	}
+	return fmt.Sprintf("(%s).%s", recv, decl.Name.Name)
+}
+
+// ReceiverName returns the receiver type name of decl (e.g. "*Calculator"
+// or "Calculator"), or "" if decl is not a method. It's shared by any
+// package that needs to format a function declaration's display name,
+// such as renderer's function-boundary labeling.
+func ReceiverName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return ""
+	}
+
+	switch t := decl.Recv.List[0].Type.(type) {
+	case *ast.StarExpr:
+		if ident, ok := t.X.(*ast.Ident); ok {
+			return "*" + ident.Name
+		}
+	case *ast.Ident:
+		return t.Name
+	}
+
+	return ""
+}
@@ -0,0 +1,57 @@
+package coverage
+
+import "testing"
+
+func TestGetUncoveredFunctions(t *testing.T) {
+	const fileName = "../example/calculator.go"
+
+	profile := &Profile{
+		Mode: "set",
+		Blocks: []Block{
+			// Add (lines 9-11): fully covered.
+			{FileName: fileName, StartLine: 9, StartCol: 37, EndLine: 11, EndCol: 2, NumStmt: 1, Count: 1},
+			// Divide (lines 24-29): the error branch is never hit.
+			{FileName: fileName, StartLine: 25, StartCol: 15, EndLine: 27, EndCol: 3, NumStmt: 1, Count: 0},
+			{FileName: fileName, StartLine: 28, StartCol: 2, EndLine: 28, EndCol: 16, NumStmt: 1, Count: 1},
+		},
+	}
+
+	functions, err := GetUncoveredFunctions(profile, fileName)
+	if err != nil {
+		t.Fatalf("GetUncoveredFunctions: %v", err)
+	}
+
+	byName := make(map[string]*FunctionCoverage)
+	for _, fn := range functions {
+		byName[fn.FuncName] = fn
+	}
+
+	add, ok := byName["(*Calculator).Add"]
+	if !ok {
+		t.Fatalf("missing function %q in %v", "(*Calculator).Add", byName)
+	}
+	if add.Statements != 1 || add.Covered != 1 || add.Percent() != 100 {
+		t.Errorf("Add coverage = %+v, want fully covered", add)
+	}
+
+	divide, ok := byName["(*Calculator).Divide"]
+	if !ok {
+		t.Fatalf("missing function %q in %v", "(*Calculator).Divide", byName)
+	}
+	if divide.Statements != 2 || divide.Covered != 1 {
+		t.Errorf("Divide coverage = %+v, want 1 of 2 statements covered", divide)
+	}
+	if pct := divide.Percent(); pct != 50 {
+		t.Errorf("Divide.Percent() = %v, want 50", pct)
+	}
+
+	// Subtract has no profile blocks at all, so it's reported as fully
+	// covered (no statements to miss), not flagged as uncovered.
+	subtract, ok := byName["(*Calculator).Subtract"]
+	if !ok {
+		t.Fatalf("missing function %q in %v", "(*Calculator).Subtract", byName)
+	}
+	if subtract.Statements != 0 || subtract.Percent() != 100 {
+		t.Errorf("Subtract coverage = %+v, want zero statements and 100%%", subtract)
+	}
+}
@@ -0,0 +1,85 @@
+package coverage
+
+import "fmt"
+
+// Merge combines multiple coverage profiles into one, mirroring the
+// semantics of gocovmerge. This lets callers aggregate coverage collected
+// from separate runs (e.g. unit tests, integration tests, and a race-mode
+// run) into a single profile before computing uncovered lines.
+//
+// All profiles must share the same coverage mode. For "count" and "atomic"
+// mode, counts of overlapping blocks are summed; for "set" mode they are
+// OR-ed together. A block's boundaries (file, start/end line and column,
+// statement count) must agree across every profile that mentions it; if
+// they disagree, Merge returns an error.
+func Merge(profiles ...*Profile) (*Profile, error) {
+	if len(profiles) == 0 {
+		return nil, fmt.Errorf("merge: no profiles given")
+	}
+
+	mode := profiles[0].Mode
+	merged := make(map[blockKey]*Block)
+	starts := make(map[blockKey]blockKey) // start position -> full key seen there
+	var order []blockKey
+
+	for _, p := range profiles {
+		if p.Mode != mode {
+			return nil, fmt.Errorf("merge: mismatched coverage modes %q and %q", mode, p.Mode)
+		}
+
+		for _, block := range p.Blocks {
+			key := blockKey{
+				FileName:  block.FileName,
+				StartLine: block.StartLine,
+				StartCol:  block.StartCol,
+				EndLine:   block.EndLine,
+				EndCol:    block.EndCol,
+			}
+
+			start := blockKey{FileName: block.FileName, StartLine: block.StartLine, StartCol: block.StartCol}
+			if seen, ok := starts[start]; ok && seen != key {
+				return nil, fmt.Errorf("merge: conflicting block boundaries at %s:%d.%d",
+					key.FileName, key.StartLine, key.StartCol)
+			}
+			starts[start] = key
+
+			existing, exists := merged[key]
+			if !exists {
+				b := block
+				merged[key] = &b
+				order = append(order, key)
+				continue
+			}
+
+			if existing.NumStmt != block.NumStmt {
+				return nil, fmt.Errorf("merge: conflicting statement count for %s:%d.%d,%d.%d",
+					key.FileName, key.StartLine, key.StartCol, key.EndLine, key.EndCol)
+			}
+
+			if mode == "set" {
+				if block.Count > 0 {
+					existing.Count = 1
+				}
+			} else {
+				existing.Count += block.Count
+			}
+		}
+	}
+
+	blocks := make([]Block, 0, len(order))
+	for _, key := range order {
+		blocks = append(blocks, *merged[key])
+	}
+
+	return &Profile{Mode: mode, Blocks: blocks}, nil
+}
+
+// blockKey identifies a block by its source location, independent of
+// which profile it came from.
+type blockKey struct {
+	FileName  string
+	StartLine int
+	StartCol  int
+	EndLine   int
+	EndCol    int
+}
@@ -0,0 +1,60 @@
+package coverage
+
+import "testing"
+
+func block(fileName string, startLine, count int) Block {
+	return Block{FileName: fileName, StartLine: startLine, StartCol: 1, EndLine: startLine, EndCol: 2, NumStmt: 1, Count: count}
+}
+
+func TestMergeSumsCountsInCountMode(t *testing.T) {
+	a := &Profile{Mode: "count", Blocks: []Block{block("f.go", 1, 1)}}
+	b := &Profile{Mode: "count", Blocks: []Block{block("f.go", 1, 2)}}
+
+	merged, err := Merge(a, b)
+	if err != nil {
+		t.Fatalf("Merge: %v", err)
+	}
+	if len(merged.Blocks) != 1 {
+		t.Fatalf("len(Blocks) = %d, want 1", len(merged.Blocks))
+	}
+	if merged.Blocks[0].Count != 3 {
+		t.Errorf("Count = %d, want 3", merged.Blocks[0].Count)
+	}
+}
+
+func TestMergeOrsCountsInSetMode(t *testing.T) {
+	a := &Profile{Mode: "set", Blocks: []Block{block("f.go", 1, 0)}}
+	b := &Profile{Mode: "set", Blocks: []Block{block("f.go", 1, 1)}}
+
+	merged, err := Merge(a, b)
+	if err != nil {
+		t.Fatalf("Merge: %v", err)
+	}
+	if merged.Blocks[0].Count != 1 {
+		t.Errorf("Count = %d, want 1 (OR of 0 and 1)", merged.Blocks[0].Count)
+	}
+}
+
+func TestMergeRejectsMismatchedModes(t *testing.T) {
+	a := &Profile{Mode: "set", Blocks: []Block{block("f.go", 1, 1)}}
+	b := &Profile{Mode: "count", Blocks: []Block{block("f.go", 1, 1)}}
+
+	if _, err := Merge(a, b); err == nil {
+		t.Fatal("expected an error for mismatched modes")
+	}
+}
+
+func TestMergeRejectsConflictingBlockBoundaries(t *testing.T) {
+	a := &Profile{Mode: "count", Blocks: []Block{{FileName: "f.go", StartLine: 1, StartCol: 1, EndLine: 1, EndCol: 2, NumStmt: 1, Count: 1}}}
+	b := &Profile{Mode: "count", Blocks: []Block{{FileName: "f.go", StartLine: 1, StartCol: 1, EndLine: 1, EndCol: 2, NumStmt: 2, Count: 1}}}
+
+	if _, err := Merge(a, b); err == nil {
+		t.Fatal("expected an error for conflicting statement counts on the same block")
+	}
+}
+
+func TestMergeNoProfiles(t *testing.T) {
+	if _, err := Merge(); err == nil {
+		t.Fatal("expected an error when given no profiles")
+	}
+}
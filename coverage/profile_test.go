@@ -0,0 +1,46 @@
+package coverage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseProfile(t *testing.T) {
+	input := `mode: set
+example/calculator.go:9.37,11.2 1 1
+example/calculator.go:24.48,27.2 2 0
+`
+	profile, err := ParseProfile(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseProfile: %v", err)
+	}
+
+	if profile.Mode != "set" {
+		t.Errorf("Mode = %q, want %q", profile.Mode, "set")
+	}
+	if len(profile.Blocks) != 2 {
+		t.Fatalf("len(Blocks) = %d, want 2", len(profile.Blocks))
+	}
+
+	want := Block{FileName: "example/calculator.go", StartLine: 9, StartCol: 37, EndLine: 11, EndCol: 2, NumStmt: 1, Count: 1}
+	if got := profile.Blocks[0]; got != want {
+		t.Errorf("Blocks[0] = %+v, want %+v", got, want)
+	}
+	if profile.Blocks[1].IsCovered() {
+		t.Errorf("Blocks[1].IsCovered() = true, want false")
+	}
+}
+
+func TestParseProfileBadModeLine(t *testing.T) {
+	_, err := ParseProfile(strings.NewReader("not a mode line\n"))
+	if err == nil {
+		t.Fatal("expected an error for a missing mode line")
+	}
+}
+
+func TestParseProfileBadBlockLine(t *testing.T) {
+	_, err := ParseProfile(strings.NewReader("mode: set\nnot a block line\n"))
+	if err == nil {
+		t.Fatal("expected an error for a malformed block line")
+	}
+}
@@ -0,0 +1,73 @@
+package coverage
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ResolveSourceFile maps a file name as it appears in a coverage profile —
+// which records each file's full import path, e.g.
+// "github.com/hanpama/uncovered/example/calculator.go" — to its actual
+// location on disk, so callers can os.Open or parser.ParseFile it.
+//
+// It does this by stripping the current module's path (read from the
+// nearest go.mod) from the front of fileName and resolving the remainder
+// against the module's root directory. If fileName doesn't start with the
+// module path, or no go.mod can be found, fileName is returned unchanged;
+// this keeps plain file system paths (as used in tests, or profiles for
+// other modules) working as-is.
+func ResolveSourceFile(fileName string) (string, error) {
+	root, modulePath, ok := findModule()
+	if !ok {
+		return fileName, nil
+	}
+
+	rel, ok := strings.CutPrefix(fileName, modulePath+"/")
+	if !ok {
+		return fileName, nil
+	}
+
+	return filepath.Join(root, rel), nil
+}
+
+// findModule walks up from the working directory to the nearest go.mod
+// and returns its directory and declared module path.
+func findModule() (dir, modulePath string, ok bool) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", "", false
+	}
+
+	for {
+		data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			mp, err := parseModulePath(data)
+			if err != nil {
+				return "", "", false
+			}
+			return dir, mp, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", false
+		}
+		dir = parent
+	}
+}
+
+// parseModulePath extracts the module path from a go.mod file's "module"
+// directive.
+func parseModulePath(data []byte) (string, error) {
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if rest, ok := strings.CutPrefix(line, "module "); ok {
+			return strings.TrimSpace(rest), nil
+		}
+	}
+	return "", fmt.Errorf("no module directive found in go.mod")
+}
@@ -0,0 +1,86 @@
+package export
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+// Cobertura writes profile to w as Cobertura XML, the
+// <coverage>/<packages>/<classes>/<lines> tree consumed by tools such as
+// SonarQube, Jenkins, and most Codecov/Coveralls integrations that don't
+// understand Go's native profile format.
+func Cobertura(w io.Writer, profile *coverage.Profile) error {
+	lineHits := linesByFile(profile)
+
+	fileNames := make([]string, 0, len(lineHits))
+	for fileName := range lineHits {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	var totalLines, totalHit int
+	for _, hits := range lineHits {
+		for _, count := range hits {
+			totalLines++
+			if count > 0 {
+				totalHit++
+			}
+		}
+	}
+
+	fmt.Fprintln(w, `<?xml version="1.0" encoding="UTF-8"?>`)
+	fmt.Fprintf(w, "<coverage line-rate=\"%.4f\" lines-covered=\"%d\" lines-valid=\"%d\">\n",
+		rate(totalHit, totalLines), totalHit, totalLines)
+	fmt.Fprintln(w, "  <packages>")
+	fmt.Fprintf(w, "    <package name=\"\" line-rate=\"%.4f\">\n", rate(totalHit, totalLines))
+	fmt.Fprintln(w, "      <classes>")
+
+	for _, fileName := range fileNames {
+		hits := lineHits[fileName]
+
+		lineNums := make([]int, 0, len(hits))
+		for line := range hits {
+			lineNums = append(lineNums, line)
+		}
+		sort.Ints(lineNums)
+
+		var fileHit int
+		for _, count := range hits {
+			if count > 0 {
+				fileHit++
+			}
+		}
+
+		escapedName := xmlEscapeAttr(fileName)
+		fmt.Fprintf(w, "        <class name=\"%s\" filename=\"%s\" line-rate=\"%.4f\">\n",
+			escapedName, escapedName, rate(fileHit, len(hits)))
+		fmt.Fprintln(w, "          <lines>")
+		for _, line := range lineNums {
+			fmt.Fprintf(w, "            <line number=\"%d\" hits=\"%d\"/>\n", line, hits[line])
+		}
+		fmt.Fprintln(w, "          </lines>")
+		fmt.Fprintln(w, "        </class>")
+	}
+
+	fmt.Fprintln(w, "      </classes>")
+	fmt.Fprintln(w, "    </package>")
+	fmt.Fprintln(w, "  </packages>")
+	fmt.Fprintln(w, "</coverage>")
+
+	return nil
+}
+
+// xmlEscapeAttr escapes s for safe use inside a double-quoted XML attribute
+// value. File names are caller-controlled (profiles can be built or merged
+// programmatically, not just read from go test -coverprofile), so they
+// aren't assumed to already be free of '&', '<', '>', or '"'.
+func xmlEscapeAttr(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -0,0 +1,36 @@
+// Package export converts coverage profiles into formats understood by
+// external tooling that doesn't speak Go's native profile format, such as
+// Coveralls, Codecov, SonarQube, and Jenkins.
+package export
+
+import "github.com/hanpama/uncovered/coverage"
+
+// linesByFile expands every block in profile into a single hit count per
+// line, taking the maximum count where blocks overlap.
+func linesByFile(profile *coverage.Profile) map[string]map[int]int {
+	result := make(map[string]map[int]int)
+
+	for _, block := range profile.Blocks {
+		lines, exists := result[block.FileName]
+		if !exists {
+			lines = make(map[int]int)
+			result[block.FileName] = lines
+		}
+
+		for line := block.StartLine; line <= block.EndLine; line++ {
+			if count, exists := lines[line]; !exists || block.Count > count {
+				lines[line] = block.Count
+			}
+		}
+	}
+
+	return result
+}
+
+// rate returns the fraction hit/total, or 0 if total is 0.
+func rate(hit, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(hit) / float64(total)
+}
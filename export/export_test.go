@@ -0,0 +1,93 @@
+package export
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+func testProfile() *coverage.Profile {
+	return &coverage.Profile{
+		Mode: "set",
+		Blocks: []coverage.Block{
+			{FileName: "f.go", StartLine: 1, StartCol: 1, EndLine: 2, EndCol: 2, NumStmt: 2, Count: 1},
+			{FileName: "f.go", StartLine: 3, StartCol: 1, EndLine: 3, EndCol: 2, NumStmt: 1, Count: 0},
+		},
+	}
+}
+
+func TestLCOV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := LCOV(&buf, testProfile()); err != nil {
+		t.Fatalf("LCOV: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"SF:f.go", "DA:1,1", "DA:2,1", "DA:3,0", "LF:3", "LH:2", "end_of_record"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestCobertura(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Cobertura(&buf, testProfile()); err != nil {
+		t.Fatalf("Cobertura: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		`<?xml version="1.0" encoding="UTF-8"?>`,
+		`<coverage line-rate="0.6667" lines-covered="2" lines-valid="3">`,
+		`<class name="f.go" filename="f.go"`,
+		`<line number="1" hits="1"/>`,
+		`<line number="3" hits="0"/>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestCoberturaEscapesFileNames(t *testing.T) {
+	profile := &coverage.Profile{
+		Mode: "set",
+		Blocks: []coverage.Block{
+			{FileName: `weird"&<>file.go`, StartLine: 1, StartCol: 1, EndLine: 2, EndCol: 2, NumStmt: 2, Count: 1},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Cobertura(&buf, profile); err != nil {
+		t.Fatalf("Cobertura: %v", err)
+	}
+
+	var doc struct {
+		XMLName  xml.Name `xml:"coverage"`
+		Packages struct {
+			Package struct {
+				Classes struct {
+					Class struct {
+						Name     string `xml:"name,attr"`
+						FileName string `xml:"filename,attr"`
+					} `xml:"class"`
+				} `xml:"classes"`
+			} `xml:"package"`
+		} `xml:"packages"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not valid XML: %v\n%s", err, buf.String())
+	}
+
+	want := `weird"&<>file.go`
+	if doc.Packages.Package.Classes.Class.Name != want {
+		t.Errorf("class name round-tripped as %q, want %q", doc.Packages.Package.Classes.Class.Name, want)
+	}
+	if doc.Packages.Package.Classes.Class.FileName != want {
+		t.Errorf("class filename round-tripped as %q, want %q", doc.Packages.Package.Classes.Class.FileName, want)
+	}
+}
@@ -0,0 +1,52 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+// LCOV writes profile to w in LCOV tracefile (.info) format.
+//
+// For each file it emits an SF: record, one DA:<line>,<hits> record per
+// executable line, and LF/LH summary records, terminated by
+// end_of_record, matching the format produced by the lcov tool itself.
+func LCOV(w io.Writer, profile *coverage.Profile) error {
+	lineHits := linesByFile(profile)
+
+	fileNames := make([]string, 0, len(lineHits))
+	for fileName := range lineHits {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	for _, fileName := range fileNames {
+		hits := lineHits[fileName]
+
+		lineNums := make([]int, 0, len(hits))
+		for line := range hits {
+			lineNums = append(lineNums, line)
+		}
+		sort.Ints(lineNums)
+
+		fmt.Fprintf(w, "SF:%s\n", fileName)
+
+		var found, hit int
+		for _, line := range lineNums {
+			count := hits[line]
+			fmt.Fprintf(w, "DA:%d,%d\n", line, count)
+			found++
+			if count > 0 {
+				hit++
+			}
+		}
+
+		fmt.Fprintf(w, "LF:%d\n", found)
+		fmt.Fprintf(w, "LH:%d\n", hit)
+		fmt.Fprintln(w, "end_of_record")
+	}
+
+	return nil
+}
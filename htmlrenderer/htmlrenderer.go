@@ -0,0 +1,186 @@
+// Package htmlrenderer produces a self-contained HTML coverage report,
+// similar to `go tool cover -html`, but focused on uncovered regions.
+//
+// Each source file is rendered as a collapsible section showing the full
+// source with line numbers and background coloring: red for uncovered
+// lines, green for covered lines, and gray for lines with no executable
+// code. A top-level index lists files sorted by uncovered-line
+// percentage, linking down to each file's section.
+package htmlrenderer
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+// Render writes a self-contained HTML report to w. uncovered and covered
+// hold the complementary sets of blocks for the same files; any line that
+// appears in neither is rendered as non-executable.
+func Render(w io.Writer, uncovered, covered []*coverage.FileBlocks) error {
+	files := mergeFiles(uncovered, covered)
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].uncoveredPercent() > files[j].uncoveredPercent()
+	})
+
+	fmt.Fprint(w, htmlHeader)
+	renderIndex(w, files)
+	for _, f := range files {
+		if err := renderFile(w, f); err != nil {
+			return fmt.Errorf("rendering %s: %w", f.fileName, err)
+		}
+	}
+	fmt.Fprint(w, htmlFooter)
+	return nil
+}
+
+type lineState int
+
+const (
+	stateNonExecutable lineState = iota
+	stateCovered
+	stateUncovered
+)
+
+// fileReport holds the per-line coverage state for a single file, merged
+// from its covered and uncovered blocks.
+type fileReport struct {
+	fileName string
+	states   map[int]lineState
+}
+
+// uncoveredPercent returns the percentage of executable lines that are
+// uncovered, used to rank files in the index.
+func (f *fileReport) uncoveredPercent() float64 {
+	var uncoveredCount, total int
+	for _, s := range f.states {
+		if s == stateNonExecutable {
+			continue
+		}
+		total++
+		if s == stateUncovered {
+			uncoveredCount++
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(uncoveredCount) / float64(total)
+}
+
+func mergeFiles(uncovered, covered []*coverage.FileBlocks) []*fileReport {
+	reports := make(map[string]*fileReport)
+	var order []string
+
+	apply := func(blocks []*coverage.FileBlocks, state lineState) {
+		for _, fb := range blocks {
+			r, exists := reports[fb.FileName]
+			if !exists {
+				r = &fileReport{fileName: fb.FileName, states: make(map[int]lineState)}
+				reports[fb.FileName] = r
+				order = append(order, fb.FileName)
+			}
+			for _, b := range fb.Blocks {
+				for line := b.StartLine; line <= b.EndLine; line++ {
+					r.states[line] = state
+				}
+			}
+		}
+	}
+
+	apply(covered, stateCovered)
+	apply(uncovered, stateUncovered)
+
+	sort.Strings(order)
+	result := make([]*fileReport, 0, len(order))
+	for _, name := range order {
+		result = append(result, reports[name])
+	}
+	return result
+}
+
+func renderIndex(w io.Writer, files []*fileReport) {
+	fmt.Fprint(w, "<h1>Coverage Report</h1>\n<table class=\"index\">\n<tr><th>File</th><th>Uncovered</th></tr>\n")
+	for _, f := range files {
+		fmt.Fprintf(w, "<tr><td><a href=\"#%s\">%s</a></td><td>%.1f%%</td></tr>\n",
+			anchor(f.fileName), html.EscapeString(f.fileName), f.uncoveredPercent())
+	}
+	fmt.Fprint(w, "</table>\n")
+}
+
+func renderFile(w io.Writer, f *fileReport) error {
+	sourcePath, err := coverage.ResolveSourceFile(f.fileName)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(w, "<details id=\"%s\" class=\"file\">\n<summary>%s (%.1f%% uncovered)</summary>\n<pre>\n",
+		anchor(f.fileName), html.EscapeString(f.fileName), f.uncoveredPercent())
+
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		class := "non-exec"
+		switch f.states[lineNum] {
+		case stateCovered:
+			class = "covered"
+		case stateUncovered:
+			class = "uncovered"
+		}
+		fmt.Fprintf(w, "<span class=\"line %s\">%5d %s</span>\n", class, lineNum, html.EscapeString(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	fmt.Fprint(w, "</pre>\n</details>\n")
+	return nil
+}
+
+// anchor turns a file path into a string usable as an HTML id/fragment.
+func anchor(fileName string) string {
+	out := make([]rune, 0, len(fileName))
+	for _, r := range fileName {
+		if r == '/' || r == '.' {
+			out = append(out, '-')
+			continue
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+const htmlHeader = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Coverage Report</title>
+<style>
+body { font-family: monospace; }
+.line { display: block; white-space: pre; }
+.covered { background: #d4f7d4; }
+.uncovered { background: #f7d4d4; }
+.non-exec { background: #eee; color: #888; }
+table.index { border-collapse: collapse; }
+table.index td, table.index th { border: 1px solid #ccc; padding: 4px 8px; }
+</style>
+</head>
+<body>
+`
+
+const htmlFooter = `</body>
+</html>
+`
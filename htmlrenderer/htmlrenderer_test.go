@@ -0,0 +1,51 @@
+package htmlrenderer
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+func TestRenderColorsLinesByState(t *testing.T) {
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "sample.go")
+	source := "package sample\n\nfunc f() {\n\tprintln(1)\n\tprintln(2)\n}\n"
+	if err := os.WriteFile(fileName, []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	covered := []*coverage.FileBlocks{{
+		FileName: fileName,
+		Blocks:   []coverage.CoverageBlock{{StartLine: 4, EndLine: 4, Covered: true}},
+	}}
+	uncovered := []*coverage.FileBlocks{{
+		FileName: fileName,
+		Blocks:   []coverage.CoverageBlock{{StartLine: 5, EndLine: 5, Covered: false}},
+	}}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, uncovered, covered); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "<!DOCTYPE html>") {
+		t.Errorf("output doesn't look like an HTML document:\n%s", out)
+	}
+	if !strings.Contains(out, fileName) {
+		t.Errorf("output doesn't mention the source file name:\n%s", out)
+	}
+	if !strings.Contains(out, `class="line covered"`) {
+		t.Errorf("covered line not rendered with the 'covered' class:\n%s", out)
+	}
+	if !strings.Contains(out, `class="line uncovered"`) {
+		t.Errorf("uncovered line not rendered with the 'uncovered' class:\n%s", out)
+	}
+	if !strings.Contains(out, `class="line non-exec"`) {
+		t.Errorf("line with no block not rendered with the 'non-exec' class:\n%s", out)
+	}
+}
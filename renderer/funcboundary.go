@@ -0,0 +1,86 @@
+package renderer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+// funcBoundary records the line range and display name of a single
+// function or method declaration, used to align uncovered-line groups to
+// function boundaries.
+type funcBoundary struct {
+	name  string
+	start int
+	end   int
+}
+
+// parseFuncBoundaries parses fileName and returns the line range of every
+// top-level function and method declaration it contains. Files that cannot
+// be parsed as Go source (or don't exist) yield no boundaries rather than
+// an error, since grouping simply falls back to unsnapped behavior.
+func parseFuncBoundaries(fileName string) []funcBoundary {
+	sourcePath, err := coverage.ResolveSourceFile(fileName)
+	if err != nil {
+		return nil
+	}
+
+	fset := token.NewFileSet()
+	astFile, err := parser.ParseFile(fset, sourcePath, nil, 0)
+	if err != nil {
+		return nil
+	}
+
+	var funcs []funcBoundary
+	for _, decl := range astFile.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		funcs = append(funcs, funcBoundary{
+			name:  funcDeclName(fn),
+			start: fset.Position(fn.Pos()).Line,
+			end:   fset.Position(fn.End()).Line,
+		})
+	}
+	return funcs
+}
+
+// enclosing returns the function boundary containing line, if any.
+func enclosing(funcs []funcBoundary, line int) (funcBoundary, bool) {
+	for _, fn := range funcs {
+		if line >= fn.start && line <= fn.end {
+			return fn, true
+		}
+	}
+	return funcBoundary{}, false
+}
+
+// overlappingFuncNames returns the display names of every function boundary
+// whose range intersects [start,end], in source order. A group's rendered
+// content can span more than one declaration (e.g. several short uncovered
+// functions close enough together to be merged into one block), so callers
+// must not assume a single name describes the whole range.
+func overlappingFuncNames(funcs []funcBoundary, start, end int) []string {
+	var names []string
+	for _, fn := range funcs {
+		if fn.end < start || fn.start > end {
+			continue
+		}
+		names = append(names, fn.name)
+	}
+	return names
+}
+
+// funcDeclName returns a display name for decl, including the receiver
+// type for methods (e.g. "func (*Calculator) Divide").
+func funcDeclName(decl *ast.FuncDecl) string {
+	recv := coverage.ReceiverName(decl)
+	if recv == "" {
+		return "func " + decl.Name.Name
+	}
+	return fmt.Sprintf("func (%s) %s", recv, decl.Name.Name)
+}
@@ -0,0 +1,50 @@
+package renderer
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+// FuncRenderer renders per-function coverage statistics, similar to the
+// output of `go tool cover -func`.
+//
+// Each function is printed on its own line as "file:Func NN.N%", followed
+// by a "total: (statements) NN.N%" footer summarizing all functions seen.
+type FuncRenderer struct {
+	// Threshold, if greater than zero, restricts the per-function lines to
+	// functions whose coverage percentage is below it. The total footer is
+	// always computed over every function regardless of Threshold.
+	Threshold float64
+}
+
+// NewFunc creates a new FuncRenderer.
+func NewFunc() *FuncRenderer {
+	return &FuncRenderer{}
+}
+
+// Render prints one line per function in functions, followed by a total
+// coverage footer.
+func (r *FuncRenderer) Render(w io.Writer, functions []*coverage.FunctionCoverage) error {
+	var totalStmts, totalCovered int
+
+	for _, fn := range functions {
+		totalStmts += fn.Statements
+		totalCovered += fn.Covered
+
+		if r.Threshold > 0 && fn.Percent() >= r.Threshold {
+			continue
+		}
+
+		fmt.Fprintf(w, "%s:%s\t%.1f%%\n", fn.FileName, fn.FuncName, fn.Percent())
+	}
+
+	total := 100.0
+	if totalStmts > 0 {
+		total = 100 * float64(totalCovered) / float64(totalStmts)
+	}
+	fmt.Fprintf(w, "total:\t(statements)\t%.1f%%\n", total)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package renderer
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+// PatchRenderer renders uncovered lines as a unified diff, with each
+// uncovered line shown as a removal ("-") against its surrounding context.
+// The output can be piped directly into tools that consume unified diffs,
+// such as CI bots that post suggested-change comments on a pull request.
+type PatchRenderer struct {
+	// ContextLines controls how many lines of context are shown around each
+	// group of uncovered lines. Defaults to contextLines if zero.
+	ContextLines int
+}
+
+// NewPatch creates a new PatchRenderer.
+func NewPatch() *PatchRenderer {
+	return &PatchRenderer{ContextLines: contextLines}
+}
+
+// Render writes a unified diff for each file with uncovered lines.
+func (r *PatchRenderer) Render(w io.Writer, uncovered []*coverage.FileUncovered) error {
+	ctx := r.contextLines()
+
+	for _, fileUncovered := range uncovered {
+		if err := r.renderFile(w, fileUncovered, ctx); err != nil {
+			return fmt.Errorf("rendering %s: %w", fileUncovered.FileName, err)
+		}
+	}
+	return nil
+}
+
+// renderFile writes the diff headers and hunks for a single file.
+func (r *PatchRenderer) renderFile(w io.Writer, fileUncovered *coverage.FileUncovered, ctx int) error {
+	sourcePath, err := coverage.ResolveSourceFile(fileUncovered.FileName)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	lines, err := readLines(file)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "--- a/%s\n", fileUncovered.FileName)
+	fmt.Fprintf(w, "+++ b/%s\n", fileUncovered.FileName)
+
+	for _, group := range groupLines(fileUncovered.FileName, fileUncovered.Lines, ctx) {
+		r.renderHunk(w, lines, group, ctx)
+	}
+
+	return nil
+}
+
+// renderHunk writes a single "@@ ... @@" hunk for group.
+func (r *PatchRenderer) renderHunk(w io.Writer, allLines []string, group lineGroup, ctx int) {
+	uncoveredSet := make(map[int]bool, len(group.lines))
+	for _, line := range group.lines {
+		uncoveredSet[line.Line] = true
+	}
+
+	startLine := max(1, group.start-ctx)
+	endLine := min(len(allLines), group.end+ctx)
+	hunkLen := endLine - startLine + 1
+
+	var removed int
+	for lineNum := startLine; lineNum <= endLine; lineNum++ {
+		if uncoveredSet[lineNum] {
+			removed++
+		}
+	}
+
+	fmt.Fprintf(w, "@@ -%d,%d +%d,%d @@\n", startLine, hunkLen, startLine, hunkLen-removed)
+	for lineNum := startLine; lineNum <= endLine; lineNum++ {
+		prefix := " "
+		if uncoveredSet[lineNum] {
+			prefix = "-"
+		}
+		fmt.Fprintf(w, "%s%s\n", prefix, allLines[lineNum-1])
+	}
+}
+
+// contextLines returns r.ContextLines, falling back to the package default.
+func (r *PatchRenderer) contextLines() int {
+	if r.ContextLines > 0 {
+		return r.ContextLines
+	}
+	return contextLines
+}
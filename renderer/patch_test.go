@@ -0,0 +1,81 @@
+package renderer
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+func TestPatchRendererRendersHunk(t *testing.T) {
+	// The content isn't valid Go, so groupLines can't snap to a function
+	// boundary and this test exercises only the diff formatting itself.
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "sample.txt")
+	source := "one\ntwo\nthree\nfour\nfive\n"
+	if err := os.WriteFile(fileName, []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	uncovered := []*coverage.FileUncovered{{
+		FileName: fileName,
+		Lines:    []coverage.UncoveredLine{{Line: 3}},
+	}}
+
+	var buf bytes.Buffer
+	if err := (&PatchRenderer{ContextLines: 1}).Render(&buf, uncovered); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "--- a/"+fileName) || !strings.Contains(out, "+++ b/"+fileName) {
+		t.Errorf("missing diff headers in output:\n%s", out)
+	}
+	if !strings.Contains(out, "@@ -2,3 +2,2 @@") {
+		t.Errorf("missing expected hunk header in output:\n%s", out)
+	}
+	if !strings.Contains(out, "-three") {
+		t.Errorf("uncovered line not marked with '-' in output:\n%s", out)
+	}
+	if !strings.Contains(out, " two") || !strings.Contains(out, " four") {
+		t.Errorf("context lines should not be marked with '-' in output:\n%s", out)
+	}
+}
+
+// TestPatchRendererOutputApplies verifies the produced diff is well-formed
+// by actually applying it with the system patch(1) tool, rather than just
+// asserting on the hunk header text.
+func TestPatchRendererOutputApplies(t *testing.T) {
+	if _, err := exec.LookPath("patch"); err != nil {
+		t.Skip("patch(1) not available")
+	}
+
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "sample.txt")
+	source := "one\ntwo\nthree\nfour\nfive\n"
+	if err := os.WriteFile(fileName, []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	uncovered := []*coverage.FileUncovered{{
+		FileName: fileName,
+		Lines:    []coverage.UncoveredLine{{Line: 3}},
+	}}
+
+	var buf bytes.Buffer
+	if err := (&PatchRenderer{ContextLines: 1}).Render(&buf, uncovered); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	cmd := exec.Command("patch", "--dry-run", "-p1", "-d", "/")
+	cmd.Stdin = &buf
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("produced diff does not apply: %v\n%s", err, stderr.String())
+	}
+}
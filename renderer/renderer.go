@@ -22,7 +22,11 @@
 //
 // Lines marked with ">" are uncovered (shown in red in terminal).
 // Consecutive uncovered sections are intelligently grouped to avoid
-// showing overlapping context.
+// showing overlapping context. When the source can be parsed as Go, a
+// group is also snapped to the start/end of its enclosing function(s) and
+// labeled with their names, so a function is never split across two
+// groups. A group spanning more than one declaration is labeled with all
+// of their names, comma-separated.
 package renderer
 
 import (
@@ -76,14 +80,19 @@ func (r *Renderer) Render(w io.Writer, uncovered []*coverage.FileUncovered) erro
 // renderFile renders uncovered lines for a single file
 func (r *Renderer) renderFile(w io.Writer, fileUncovered *coverage.FileUncovered) error {
 	// Read file content
-	file, err := os.Open(fileUncovered.FileName)
+	sourcePath, err := coverage.ResolveSourceFile(fileUncovered.FileName)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(sourcePath)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
 	// Read all lines
-	lines, err := r.readLines(file)
+	lines, err := readLines(file)
 	if err != nil {
 		return err
 	}
@@ -91,14 +100,18 @@ func (r *Renderer) renderFile(w io.Writer, fileUncovered *coverage.FileUncovered
 	// Print file header with statistics
 	r.printFileHeader(w, fileUncovered.FileName, len(fileUncovered.Lines), len(lines))
 
-	// Group consecutive lines to avoid duplicate context
-	groups := r.groupLines(fileUncovered.Lines)
+	// Group consecutive lines to avoid duplicate context, snapping to
+	// enclosing function boundaries so a function is never split
+	groups := groupLines(fileUncovered.FileName, fileUncovered.Lines, contextLines)
 
 	// Render each group
 	for i, group := range groups {
 		if i > 0 {
 			fmt.Fprintln(w)
 		}
+		if len(group.funcNames) > 0 {
+			fmt.Fprintf(w, "\033[1m── %s ──\033[0m\n", strings.Join(group.funcNames, ", "))
+		}
 		r.renderGroup(w, lines, group)
 	}
 
@@ -107,17 +120,28 @@ func (r *Renderer) renderFile(w io.Writer, fileUncovered *coverage.FileUncovered
 
 // lineGroup represents a group of consecutive uncovered lines
 type lineGroup struct {
-	start int // first uncovered line number
-	end   int // last uncovered line number
-	lines []coverage.UncoveredLine
+	start     int      // first line of the group before context is added, snapped to the enclosing function's start if known
+	end       int      // last line of the group before context is added, snapped to the enclosing function's end if known
+	funcNames []string // display names of every function the range overlaps, or nil if none was found
+	lines     []coverage.UncoveredLine
 }
 
-// groupLines groups consecutive uncovered lines
-func (r *Renderer) groupLines(lines []coverage.UncoveredLine) []lineGroup {
+// groupLines groups consecutive uncovered lines, merging lines that are
+// close enough that their context regions (ctx lines before and after)
+// would otherwise overlap. When fileName can be parsed as Go source, each
+// group's boundaries are then snapped to the start/end of its enclosing
+// function, so a function is never split across two groups and its
+// signature is always shown alongside the uncovered lines within it. A
+// group that still ends up spanning more than one declaration (e.g. several
+// short uncovered functions close enough together to merge into one block)
+// is labeled with every function it covers, not just the first.
+func groupLines(fileName string, lines []coverage.UncoveredLine, ctx int) []lineGroup {
 	if len(lines) == 0 {
 		return nil
 	}
 
+	funcs := parseFuncBoundaries(fileName)
+
 	groups := make([]lineGroup, 0)
 	currentGroup := lineGroup{
 		start: lines[0].Line,
@@ -126,8 +150,8 @@ func (r *Renderer) groupLines(lines []coverage.UncoveredLine) []lineGroup {
 	}
 
 	for i := 1; i < len(lines); i++ {
-		// If lines are close enough (within 2*contextLines), merge them into the same group
-		if lines[i].Line <= currentGroup.end+2*contextLines+1 {
+		// If lines are close enough (within 2*ctx), merge them into the same group
+		if lines[i].Line <= currentGroup.end+2*ctx+1 {
 			currentGroup.end = lines[i].Line
 			currentGroup.lines = append(currentGroup.lines, lines[i])
 		} else {
@@ -141,9 +165,67 @@ func (r *Renderer) groupLines(lines []coverage.UncoveredLine) []lineGroup {
 	}
 	groups = append(groups, currentGroup)
 
+	for i := range groups {
+		snapToFunc(&groups[i], funcs)
+	}
+
+	// Snapping can expand two previously distinct groups to the same
+	// enclosing function, so they now overlap; merge those back together.
+	groups = mergeOverlapping(groups)
+
+	// A group's range can span more than one declaration, either because
+	// proximity merged raw uncovered lines from several short functions
+	// before snapping, or because mergeOverlapping just joined two
+	// separately-snapped groups. Label every such group with every
+	// function it covers, rather than just the first one found.
+	for i := range groups {
+		groups[i].funcNames = overlappingFuncNames(funcs, groups[i].start, groups[i].end)
+	}
+
 	return groups
 }
 
+// mergeOverlapping merges groups whose [start,end] ranges overlap or
+// touch, in the order they appear. Snapping to a function boundary can
+// cause this when two uncovered regions fall inside the same function.
+func mergeOverlapping(groups []lineGroup) []lineGroup {
+	if len(groups) == 0 {
+		return groups
+	}
+
+	merged := make([]lineGroup, 0, len(groups))
+	current := groups[0]
+
+	for _, g := range groups[1:] {
+		if g.start > current.end+1 {
+			merged = append(merged, current)
+			current = g
+			continue
+		}
+
+		if g.end > current.end {
+			current.end = g.end
+		}
+		current.lines = append(current.lines, g.lines...)
+	}
+	merged = append(merged, current)
+
+	return merged
+}
+
+// snapToFunc expands group's start to the start of the function enclosing
+// its first line, and its end to the end of the function enclosing its last
+// line, where such functions exist.
+func snapToFunc(group *lineGroup, funcs []funcBoundary) {
+	if fn, ok := enclosing(funcs, group.start); ok {
+		group.start = fn.start
+		group.end = max(group.end, fn.end)
+	}
+	if fn, ok := enclosing(funcs, group.end); ok {
+		group.end = fn.end
+	}
+}
+
 // renderGroup renders a group of uncovered lines with context
 func (r *Renderer) renderGroup(w io.Writer, allLines []string, group lineGroup) {
 	// Create a set of uncovered line numbers for quick lookup
@@ -184,7 +266,7 @@ func (r *Renderer) printFileHeader(w io.Writer, fileName string, uncoveredCount,
 }
 
 // readLines reads all lines from a file
-func (r *Renderer) readLines(file *os.File) ([]string, error) {
+func readLines(file *os.File) ([]string, error) {
 	lines := make([]string, 0)
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
@@ -0,0 +1,98 @@
+package renderer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+func TestGroupLinesMergesRegionsSnappedToTheSameFunction(t *testing.T) {
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "big.go")
+	source := `package sample
+
+func bigFunc() {
+	a := 1
+	_ = a
+	b := 2
+	_ = b
+	c := 3
+	_ = c
+	d := 4
+	_ = d
+	e := 5
+	_ = e
+}
+`
+	if err := os.WriteFile(fileName, []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Lines 4 and 12 are far enough apart (with ctx=1) to start as two
+	// separate groups, but both fall inside bigFunc (lines 3-14), so
+	// snapping must merge them into a single group rather than emitting
+	// two identical [3,14] groups.
+	lines := []coverage.UncoveredLine{{Line: 4}, {Line: 12}}
+
+	groups := groupLines(fileName, lines, 1)
+	if len(groups) != 1 {
+		t.Fatalf("len(groups) = %d, want 1; groups = %+v", len(groups), groups)
+	}
+
+	got := groups[0]
+	if got.start != 3 || got.end != 14 {
+		t.Errorf("group range = [%d,%d], want [3,14]", got.start, got.end)
+	}
+	if len(got.funcNames) != 1 || got.funcNames[0] != "func bigFunc" {
+		t.Errorf("group.funcNames = %v, want [%q]", got.funcNames, "func bigFunc")
+	}
+	if len(got.lines) != 2 {
+		t.Errorf("len(group.lines) = %d, want 2 (both original uncovered lines)", len(got.lines))
+	}
+}
+
+func TestGroupLinesLabelsAllFunctionsInAMergedBlock(t *testing.T) {
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "short.go")
+	source := `package sample
+
+func A() int {
+	return 1
+}
+
+func B() int {
+	return 2
+}
+
+func C() int {
+	return 3
+}
+`
+	if err := os.WriteFile(fileName, []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// One uncovered line per function. With ctx=3 the raw line gaps (well
+	// under 2*ctx+1) merge A, B and C into a single block before snapping,
+	// so the resulting group must be labeled with all three names instead
+	// of just A's.
+	lines := []coverage.UncoveredLine{{Line: 4}, {Line: 8}, {Line: 12}}
+
+	groups := groupLines(fileName, lines, 3)
+	if len(groups) != 1 {
+		t.Fatalf("len(groups) = %d, want 1; groups = %+v", len(groups), groups)
+	}
+
+	want := []string{"func A", "func B", "func C"}
+	got := groups[0].funcNames
+	if len(got) != len(want) {
+		t.Fatalf("group.funcNames = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("group.funcNames = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package renderer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+// ReviewComment is a single inline review comment targeting one line of a
+// file, shaped to match the GitHub and GitLab pull/merge request review
+// comment APIs (e.g. POST /repos/:owner/:repo/pulls/:number/comments).
+type ReviewComment struct {
+	Path string `json:"path"`
+	Line int    `json:"line"`
+	Side string `json:"side"`
+	Body string `json:"body"`
+}
+
+// ReviewRenderer produces one ReviewComment per group of uncovered lines,
+// suitable for posting back to a pull request as inline review comments.
+type ReviewRenderer struct {
+	// ContextLines controls how groups are formed; it uses the same
+	// grouping as Renderer and PatchRenderer. Defaults to contextLines if zero.
+	ContextLines int
+}
+
+// NewReview creates a new ReviewRenderer.
+func NewReview() *ReviewRenderer {
+	return &ReviewRenderer{ContextLines: contextLines}
+}
+
+// Comments returns the review comments for uncovered, without performing
+// any I/O of its own.
+func (r *ReviewRenderer) Comments(uncovered []*coverage.FileUncovered) []ReviewComment {
+	ctx := r.ContextLines
+	if ctx == 0 {
+		ctx = contextLines
+	}
+
+	var comments []ReviewComment
+	for _, fileUncovered := range uncovered {
+		for _, group := range groupLines(fileUncovered.FileName, fileUncovered.Lines, ctx) {
+			comments = append(comments, ReviewComment{
+				Path: fileUncovered.FileName,
+				Line: group.end,
+				Side: "RIGHT",
+				Body: fmt.Sprintf("%d uncovered line(s) in this range (lines %d-%d).", len(group.lines), group.start, group.end),
+			})
+		}
+	}
+	return comments
+}
+
+// Render writes the review comments as a JSON array to w.
+func (r *ReviewRenderer) Render(w io.Writer, uncovered []*coverage.FileUncovered) error {
+	return json.NewEncoder(w).Encode(r.Comments(uncovered))
+}
@@ -0,0 +1,41 @@
+package renderer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hanpama/uncovered/coverage"
+)
+
+func TestReviewRendererOneCommentPerGroup(t *testing.T) {
+	// Not valid Go, so groupLines can't snap to a function boundary; this
+	// keeps the test focused on one-comment-per-group grouping itself.
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "sample.txt")
+	source := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\n18\n19\n20\n"
+	if err := os.WriteFile(fileName, []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	uncovered := []*coverage.FileUncovered{{
+		FileName: fileName,
+		// Line 2 and line 18 are far enough apart that they must stay in
+		// separate groups, and therefore separate comments.
+		Lines: []coverage.UncoveredLine{{Line: 2}, {Line: 18}},
+	}}
+
+	comments := (&ReviewRenderer{ContextLines: 1}).Comments(uncovered)
+	if len(comments) != 2 {
+		t.Fatalf("len(comments) = %d, want 2 (one per group): %+v", len(comments), comments)
+	}
+
+	for _, c := range comments {
+		if c.Path != fileName {
+			t.Errorf("comment.Path = %q, want %q", c.Path, fileName)
+		}
+		if c.Side != "RIGHT" {
+			t.Errorf("comment.Side = %q, want %q", c.Side, "RIGHT")
+		}
+	}
+}